@@ -0,0 +1,122 @@
+package aws
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/terraform-providers/terraform-provider-aws/aws/awserrors"
+)
+
+// authorizationMessageTTL bounds how long a decoded
+// EncodedAuthorizationMessage is cached before being decoded again. AWS
+// documents these tokens as stable for roughly 15 minutes, so a plan
+// touching hundreds of resources behind the same denied principal need
+// not hammer STS once per resource.
+const authorizationMessageTTL = 15 * time.Minute
+
+// authorizationMessageCache is a per-session cache of decoded STS
+// authorization failure messages, keyed by the encoded token.
+type authorizationMessageCache struct {
+	mu      sync.Mutex
+	entries map[string]cachedAuthorizationMessage
+}
+
+type cachedAuthorizationMessage struct {
+	message   string
+	expiresAt time.Time
+}
+
+func newAuthorizationMessageCache() *authorizationMessageCache {
+	return &authorizationMessageCache{entries: make(map[string]cachedAuthorizationMessage)}
+}
+
+func (c *authorizationMessageCache) get(token string) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[token]
+	if !ok {
+		return "", false
+	}
+	if time.Now().After(entry.expiresAt) {
+		delete(c.entries, token)
+		return "", false
+	}
+	return entry.message, true
+}
+
+func (c *authorizationMessageCache) put(token, message string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries[token] = cachedAuthorizationMessage{
+		message:   message,
+		expiresAt: time.Now().Add(authorizationMessageTTL),
+	}
+}
+
+// decodedAuthorizationMessage is the JSON document STS's
+// DecodeAuthorizationMessage returns: the IAM decision that produced the
+// original "Encoded authorization failure message" blob.
+type decodedAuthorizationMessage struct {
+	Allowed           bool              `json:"allowed"`
+	ExplicitDeny      bool              `json:"explicitDeny"`
+	MatchedStatements []json.RawMessage `json:"matchedStatements"`
+	Context           struct {
+		Principal struct {
+			ARN string `json:"arn"`
+		} `json:"principal"`
+		Action   string `json:"action"`
+		Resource string `json:"resource"`
+	} `json:"context"`
+}
+
+// decodeAuthorizationMessageHandler returns a request.Handler that decodes
+// STS EncodedAuthorizationMessage tokens in req.Error and replaces it with
+// an *awserrors.AuthorizationFailureError. Results are cached in cache by
+// token for authorizationMessageTTL. Config.ConfigureSession registers the
+// returned handler on a session's Handlers.CompleteAttempt list; see
+// providerConfigure in provider.go.
+func decodeAuthorizationMessageHandler(decoder stsDecoder, cache *authorizationMessageCache) func(*request.Request) {
+	return func(req *request.Request) {
+		if req.Error == nil {
+			return
+		}
+
+		groups := encodedFailureMessagePattern.FindStringSubmatch(req.Error.Error())
+		if groups == nil || len(groups) <= 1 {
+			return
+		}
+		prefix, token, suffix := groups[1], groups[2], groups[3]
+
+		msg, ok := cache.get(token)
+		if !ok {
+			decoded, err := decodeAuthorizationMessage(decoder, token)
+			if err != nil {
+				log.Printf("[WARN] Attempted to decode authorization message, but received: %v", err)
+				return
+			}
+			msg = decoded
+			cache.put(token, msg)
+		}
+
+		var parsed decodedAuthorizationMessage
+		authzErr := &awserrors.AuthorizationFailureError{
+			Err: fmt.Errorf("%s Authorization failure message: '%s'%s: %w", prefix, msg, suffix, req.Error),
+		}
+		if jsonErr := json.Unmarshal([]byte(msg), &parsed); jsonErr == nil {
+			authzErr.Principal = parsed.Context.Principal.ARN
+			authzErr.Action = parsed.Context.Action
+			authzErr.Resource = parsed.Context.Resource
+		} else {
+			log.Printf("[WARN] Decoded authorization message was not valid JSON: %v", jsonErr)
+		}
+
+		log.Printf("[TRACE] Decoded authorization failure message: %s", msg)
+		req.Error = authzErr
+	}
+}
@@ -0,0 +1,33 @@
+package aws
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAuthorizationMessageCacheGetPut(t *testing.T) {
+	cache := newAuthorizationMessageCache()
+
+	if _, ok := cache.get("token"); ok {
+		t.Fatal("get() on empty cache returned ok = true")
+	}
+
+	cache.put("token", "decoded message")
+
+	got, ok := cache.get("token")
+	if !ok || got != "decoded message" {
+		t.Fatalf("get() = (%q, %v), want (%q, true)", got, ok, "decoded message")
+	}
+}
+
+func TestAuthorizationMessageCacheExpiry(t *testing.T) {
+	cache := newAuthorizationMessageCache()
+	cache.entries["token"] = cachedAuthorizationMessage{
+		message:   "stale",
+		expiresAt: time.Now().Add(-time.Minute),
+	}
+
+	if _, ok := cache.get("token"); ok {
+		t.Fatal("get() returned ok = true for an expired entry")
+	}
+}
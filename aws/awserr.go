@@ -1,14 +1,17 @@
 package aws
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"log"
+	"regexp"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/sts"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
-	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/awsretry"
 )
 
 // Returns true if the error matches all these conditions:
@@ -28,53 +31,45 @@ func isAWSErrRequestFailureStatusCode(err error, statusCode int) bool {
 	return tfawserr.ErrStatusCodeEquals(err, statusCode)
 }
 
-func retryOnAwsCode(code string, f func() (interface{}, error)) (interface{}, error) {
-	var resp interface{}
-	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
-		var err error
-		resp, err = f()
-		if err != nil {
-			if tfawserr.ErrCodeEquals(err, code) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
+// backoffPolicy builds the common backoff/jitter shape shared by
+// retryOnAwsCode and RetryOnAwsCodes, varying only by matcher and timeout.
+func backoffPolicy(timeout time.Duration, codes ...string) awsretry.RetryPolicy {
+	return awsretry.RetryPolicy{
+		Matchers:       []awsretry.Matcher{awsretry.MatchErrCodes(codes...)},
+		InitialDelay:   1 * time.Second,
+		MaxDelay:       30 * time.Second,
+		Multiplier:     2,
+		JitterFraction: 0.1,
+		Timeout:        timeout,
+	}
+}
+
+// doRetry runs policy via awsretry.Do and, matching the baseline
+// resource.Retry-based helpers this replaces, makes one final attempt
+// after the policy's budget is exhausted rather than giving up outright.
+func doRetry(policy awsretry.RetryPolicy, f func() (interface{}, error)) (interface{}, error) {
+	resp, err := awsretry.Do(context.Background(), policy, f)
 
-	if tfresource.TimedOut(err) {
+	var retryErr *awsretry.RetryError
+	if errors.As(err, &retryErr) {
 		resp, err = f()
 	}
 
 	return resp, err
 }
 
-// RetryOnAwsCodes retries AWS error codes for one minute
+// retryOnAwsCode is a thin shim over awsretry.Do kept for existing call
+// sites; it preserves the baseline's 2-minute retry window. New code
+// should build an awsretry.RetryPolicy directly.
+func retryOnAwsCode(code string, f func() (interface{}, error)) (interface{}, error) {
+	return doRetry(backoffPolicy(2*time.Minute, code), f)
+}
+
+// RetryOnAwsCodes retries AWS error codes for one minute using the
+// backoffPolicy shape above, run through awsretry.Do.
 // Note: This function will be moved out of the aws package in the future.
 func RetryOnAwsCodes(codes []string, f func() (interface{}, error)) (interface{}, error) {
-	var resp interface{}
-	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
-		var err error
-		resp, err = f()
-		if err != nil {
-			var awsErr awserr.Error
-			if errors.As(err, &awsErr) {
-				for _, code := range codes {
-					if awsErr.Code() == code {
-						return resource.RetryableError(err)
-					}
-				}
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
-
-	if tfresource.TimedOut(err) {
-		resp, err = f()
-	}
-
-	return resp, err
+	return doRetry(backoffPolicy(1*time.Minute, codes...), f)
 }
 
 var encodedFailureMessagePattern = regexp.MustCompile(`(?i)(.*) Encoded authorization failure message: ([\w-]+) ?( .*)?`)
@@ -83,6 +78,18 @@ type stsDecoder interface {
 	DecodeAuthorizationMessage(input *sts.DecodeAuthorizationMessageInput) (*sts.DecodeAuthorizationMessageOutput, error)
 }
 
+// decodeAuthorizationMessage calls sts:DecodeAuthorizationMessage and
+// returns the human-readable decoded message.
+func decodeAuthorizationMessage(decoder stsDecoder, encodedMessage string) (string, error) {
+	result, err := decoder.DecodeAuthorizationMessage(&sts.DecodeAuthorizationMessageInput{
+		EncodedMessage: aws.String(encodedMessage),
+	})
+	if err != nil {
+		return "", err
+	}
+	return aws.StringValue(result.DecodedMessage), nil
+}
+
 // decodeError replaces encoded authorization messages with the
 // decoded results
 func decodeAWSError(decoder stsDecoder, err error) error {
@@ -90,11 +97,8 @@ func decodeAWSError(decoder stsDecoder, err error) error {
 	if err != nil && decoder != nil {
 		groups := encodedFailureMessagePattern.FindStringSubmatch(err.Error())
 		if groups != nil && len(groups) > 1 {
-			result, decodeErr := decoder.DecodeAuthorizationMessage(&sts.DecodeAuthorizationMessageInput{
-				EncodedMessage: aws.String(groups[2]),
-			})
+			msg, decodeErr := decodeAuthorizationMessage(decoder, groups[2])
 			if decodeErr == nil {
-				msg := aws.StringValue(result.DecodedMessage)
 				return fmt.Errorf("%s Authorization failure message: '%s'%s", groups[1], msg, groups[3])
 			}
 			log.Printf("[WARN] Attempted to decode authorization message, but received: %v", decodeErr)
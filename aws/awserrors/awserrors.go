@@ -0,0 +1,131 @@
+// Package awserrors provides typed representations of common AWS failure
+// shapes, so resource Read functions can use errors.As instead of the
+// current per-service string comparisons against raw awserr.Error values.
+package awserrors
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+// NotFoundError indicates the requested resource does not exist.
+type NotFoundError struct {
+	Err error
+}
+
+func (e *NotFoundError) Error() string { return e.Err.Error() }
+func (e *NotFoundError) Unwrap() error { return e.Err }
+
+// AccessDeniedError indicates the caller lacks permission for the request.
+type AccessDeniedError struct {
+	Err error
+}
+
+func (e *AccessDeniedError) Error() string { return e.Err.Error() }
+func (e *AccessDeniedError) Unwrap() error { return e.Err }
+
+// ThrottledError indicates the request was rejected due to rate limiting
+// or a request quota, and is generally safe to retry with backoff.
+type ThrottledError struct {
+	Err error
+}
+
+func (e *ThrottledError) Error() string { return e.Err.Error() }
+func (e *ThrottledError) Unwrap() error { return e.Err }
+
+// BucketRegionError mirrors S3's special-case 301 response: the bucket
+// exists, but in a different region than the client is configured for.
+type BucketRegionError struct {
+	Bucket        string
+	RequestRegion string
+	ActualRegion  string
+	Err           error
+}
+
+func (e *BucketRegionError) Error() string {
+	return fmt.Sprintf("S3 bucket %q is in region %q, not the configured region %q: %s", e.Bucket, e.ActualRegion, e.RequestRegion, e.Err)
+}
+func (e *BucketRegionError) Unwrap() error { return e.Err }
+
+// AuthorizationFailureError wraps a decoded STS EncodedAuthorizationMessage
+// with its structured IAM decision fields.
+type AuthorizationFailureError struct {
+	Principal string
+	Action    string
+	Resource  string
+	Err       error
+}
+
+func (e *AuthorizationFailureError) Error() string { return e.Err.Error() }
+func (e *AuthorizationFailureError) Unwrap() error { return e.Err }
+
+// classKey identifies an AWS service's error code.
+type classKey struct {
+	service string
+	code    string
+}
+
+// registry maps service+code pairs to a constructor for the typed error
+// they should become, so new services can opt in without touching call
+// sites in resource code.
+var registry = map[classKey]func(err error) error{}
+
+// Register associates service and code with a constructor for a typed
+// error. Called from an init func by code that knows a service's error
+// codes (e.g. a service-specific file registering its NotFound codes).
+func Register(service, code string, newTyped func(err error) error) {
+	registry[classKey{service: service, code: code}] = newTyped
+}
+
+// Wrap looks up service+code in the registry for err's AWS error code and,
+// if present, returns the typed error it constructs. Otherwise it returns
+// err unchanged.
+func Wrap(service string, err error) error {
+	var awsErr awserr.Error
+	if !errors.As(err, &awsErr) {
+		return err
+	}
+	if newTyped, ok := registry[classKey{service: service, code: awsErr.Code()}]; ok {
+		return newTyped(err)
+	}
+	return err
+}
+
+// AsNotFound reports whether err is, or wraps, a *NotFoundError.
+func AsNotFound(err error) (*NotFoundError, bool) {
+	var target *NotFoundError
+	ok := errors.As(err, &target)
+	return target, ok
+}
+
+// AsAccessDenied reports whether err is, or wraps, a *AccessDeniedError.
+func AsAccessDenied(err error) (*AccessDeniedError, bool) {
+	var target *AccessDeniedError
+	ok := errors.As(err, &target)
+	return target, ok
+}
+
+// AsThrottled reports whether err is, or wraps, a *ThrottledError.
+func AsThrottled(err error) (*ThrottledError, bool) {
+	var target *ThrottledError
+	ok := errors.As(err, &target)
+	return target, ok
+}
+
+// AsBucketRegionError reports whether err is, or wraps, a
+// *BucketRegionError.
+func AsBucketRegionError(err error) (*BucketRegionError, bool) {
+	var target *BucketRegionError
+	ok := errors.As(err, &target)
+	return target, ok
+}
+
+// AsAuthorizationFailure reports whether err is, or wraps, a
+// *AuthorizationFailureError.
+func AsAuthorizationFailure(err error) (*AuthorizationFailureError, bool) {
+	var target *AuthorizationFailureError
+	ok := errors.As(err, &target)
+	return target, ok
+}
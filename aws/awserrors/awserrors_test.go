@@ -0,0 +1,52 @@
+package awserrors
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+func TestAsNotFoundThroughWrap(t *testing.T) {
+	base := &NotFoundError{Err: errors.New("role not found")}
+	wrapped := fmt.Errorf("reading role: %w", base)
+
+	got, ok := AsNotFound(wrapped)
+	if !ok {
+		t.Fatal("AsNotFound() ok = false, want true")
+	}
+	if got != base {
+		t.Fatalf("AsNotFound() = %v, want %v", got, base)
+	}
+
+	if _, ok := AsAccessDenied(wrapped); ok {
+		t.Fatal("AsAccessDenied() ok = true for a NotFoundError, want false")
+	}
+}
+
+func TestWrapUsesRegisteredConstructor(t *testing.T) {
+	err := awserr.New("NoSuchEntity", "role not found", nil)
+
+	wrapped := Wrap("iam", err)
+
+	if _, ok := AsNotFound(wrapped); !ok {
+		t.Fatalf("Wrap() = %v, want a *NotFoundError", wrapped)
+	}
+}
+
+func TestWrapLeavesUnregisteredCodeUnchanged(t *testing.T) {
+	err := awserr.New("SomeOtherCode", "nope", nil)
+
+	if wrapped := Wrap("iam", err); wrapped != err {
+		t.Fatalf("Wrap() = %v, want err unchanged", wrapped)
+	}
+}
+
+func TestWrapLeavesUnregisteredServiceUnchanged(t *testing.T) {
+	err := awserr.New("NoSuchEntity", "role not found", nil)
+
+	if wrapped := Wrap("some-other-service", err); wrapped != err {
+		t.Fatalf("Wrap() = %v, want err unchanged", wrapped)
+	}
+}
@@ -0,0 +1,7 @@
+package awserrors
+
+func init() {
+	Register("iam", "NoSuchEntity", func(err error) error {
+		return &NotFoundError{Err: err}
+	})
+}
@@ -0,0 +1,43 @@
+package aws
+
+import (
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/sts"
+)
+
+// Config holds provider-level settings that apply across every AWS
+// service client the provider constructs.
+type Config struct {
+	// DecodeAuthorizationMessages controls whether STS
+	// EncodedAuthorizationMessage values returned by any AWS API call are
+	// automatically decoded into their human-readable form. Corresponds to
+	// the provider's decode_authorization_messages argument.
+	DecodeAuthorizationMessages bool
+}
+
+// ConfigureSession registers Config's cross-cutting request handlers on
+// sess, so every service client built from it picks them up.
+func (c *Config) ConfigureSession(sess *session.Session) {
+	if !c.DecodeAuthorizationMessages {
+		return
+	}
+
+	decoder := sts.New(sess)
+	cache := newAuthorizationMessageCache()
+	sess.Handlers.CompleteAttempt.PushBack(decodeAuthorizationMessageHandler(decoder, cache))
+}
+
+// AWSClient holds the per-service clients built from a configured
+// session; it is the meta value CRUD functions type-assert.
+type AWSClient struct {
+	iamconn *iam.IAM
+}
+
+// Client builds the service clients used by resource CRUD functions from
+// a session already configured by ConfigureSession.
+func (c *Config) Client(sess *session.Session) *AWSClient {
+	return &AWSClient{
+		iamconn: iam.New(sess),
+	}
+}
@@ -0,0 +1,74 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+func TestAWSErrorClassifierClassify(t *testing.T) {
+	classifier := NewAWSErrorClassifier().
+		WithCode(ClassNotFound, "NoSuchEntity").
+		WithCode(ClassThrottled, "Throttling").
+		WithStatusCode(ClassNotFound, 404).
+		WithMessage(ClassEventualConsistency, "try again")
+
+	tests := []struct {
+		name      string
+		err       error
+		wantClass ErrorClass
+		wantOK    bool
+	}{
+		{
+			name:      "matches by code",
+			err:       awserr.New("NoSuchEntity", "role not found", nil),
+			wantClass: ClassNotFound,
+			wantOK:    true,
+		},
+		{
+			name:      "matches by status code when code doesn't match",
+			err:       awserr.NewRequestFailure(awserr.New("SomeOtherCode", "gone", nil), 404, "req-id"),
+			wantClass: ClassNotFound,
+			wantOK:    true,
+		},
+		{
+			name:      "matches by message substring",
+			err:       awserr.New("SomeCode", "please try again later", nil),
+			wantClass: ClassEventualConsistency,
+			wantOK:    true,
+		},
+		{
+			name:   "no match",
+			err:    awserr.New("AccessDenied", "nope", nil),
+			wantOK: false,
+		},
+		{
+			name:   "nil error never matches",
+			err:    nil,
+			wantOK: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			class, ok := classifier.Classify(tt.err)
+			if ok != tt.wantOK {
+				t.Fatalf("Classify() ok = %v, want %v", ok, tt.wantOK)
+			}
+			if ok && class != tt.wantClass {
+				t.Fatalf("Classify() class = %v, want %v", class, tt.wantClass)
+			}
+		})
+	}
+}
+
+func TestAWSErrorClassifierFirstMatchWins(t *testing.T) {
+	classifier := NewAWSErrorClassifier().
+		WithCode(ClassThrottled, "Throttling").
+		WithCode(ClassRetryable, "Throttling")
+
+	class, ok := classifier.Classify(awserr.New("Throttling", "slow down", nil))
+	if !ok || class != ClassThrottled {
+		t.Fatalf("Classify() = (%v, %v), want (%v, true)", class, ok, ClassThrottled)
+	}
+}
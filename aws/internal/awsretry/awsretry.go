@@ -0,0 +1,182 @@
+// Package awsretry provides a generalized retry framework for AWS API
+// calls. It replaces the ad-hoc, fixed-duration resource.Retry loops
+// scattered across the provider with a single RetryPolicy type that
+// callers configure once per error class: backoff, jitter, attempt caps,
+// and an overall timeout, all of which respect context cancellation.
+package awsretry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+// Matcher reports whether err should be treated as retryable.
+type Matcher func(err error) bool
+
+// MatchErrCode returns a Matcher that matches awserr.Error values whose
+// code equals code.
+func MatchErrCode(code string) Matcher {
+	return MatchErrCodes(code)
+}
+
+// MatchErrCodes returns a Matcher that matches awserr.Error values whose
+// code is any of codes.
+func MatchErrCodes(codes ...string) Matcher {
+	return func(err error) bool {
+		var awsErr awserr.Error
+		if !errors.As(err, &awsErr) {
+			return false
+		}
+		for _, code := range codes {
+			if awsErr.Code() == code {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// MatchStatusCode returns a Matcher that matches awserr.RequestFailure
+// values with the given HTTP status code. Prefer MatchErrCode except for
+// older APIs (e.g. S3) that sometimes respond with a status code only.
+func MatchStatusCode(statusCode int) Matcher {
+	return func(err error) bool {
+		var reqErr awserr.RequestFailure
+		return errors.As(err, &reqErr) && reqErr.StatusCode() == statusCode
+	}
+}
+
+// MatchMessageSubstring returns a Matcher that matches awserr.Error values
+// whose message contains substr.
+func MatchMessageSubstring(substr string) Matcher {
+	return func(err error) bool {
+		var awsErr awserr.Error
+		return errors.As(err, &awsErr) && strings.Contains(awsErr.Message(), substr)
+	}
+}
+
+// RetryPolicy describes how Do should retry a single class of AWS error.
+type RetryPolicy struct {
+	// Matchers are consulted in order; the first to return true marks the
+	// error retryable. An error matched by none of them is returned
+	// immediately.
+	Matchers []Matcher
+
+	// InitialDelay is the delay before the first retry.
+	InitialDelay time.Duration
+	// MaxDelay caps the delay between retries, after backoff and jitter.
+	MaxDelay time.Duration
+	// Multiplier scales the delay after each attempt. Values <= 1 disable
+	// backoff (every retry waits InitialDelay).
+	Multiplier float64
+	// JitterFraction randomizes each computed delay by +/- this fraction,
+	// e.g. 0.1 for +/-10%.
+	JitterFraction float64
+	// MaxAttempts caps the number of calls to f, including the first.
+	// Zero means unbounded (Timeout is then the only limit).
+	MaxAttempts int
+	// Timeout bounds the total time spent in Do.
+	Timeout time.Duration
+}
+
+func (p RetryPolicy) retryable(err error) bool {
+	for _, m := range p.Matchers {
+		if m(err) {
+			return true
+		}
+	}
+	return false
+}
+
+// delay computes the backoff before the given retry attempt (1-indexed:
+// the delay waited before attempt number n+1).
+func (p RetryPolicy) delay(attempt int) time.Duration {
+	mult := p.Multiplier
+	if mult < 1 {
+		mult = 1
+	}
+
+	d := float64(p.InitialDelay)
+	for i := 1; i < attempt; i++ {
+		d *= mult
+	}
+	if p.MaxDelay > 0 && d > float64(p.MaxDelay) {
+		d = float64(p.MaxDelay)
+	}
+	if p.JitterFraction > 0 {
+		d += d * p.JitterFraction * (2*rand.Float64() - 1)
+	}
+	if p.MaxDelay > 0 && d > float64(p.MaxDelay) {
+		d = float64(p.MaxDelay)
+	}
+	if d < 0 {
+		d = 0
+	}
+	return time.Duration(d)
+}
+
+// Attempt records the outcome of a single call made by Do.
+type Attempt struct {
+	Number int
+	Err    error
+	Delay  time.Duration
+}
+
+// RetryError is returned once a RetryPolicy's attempt or time budget is
+// exhausted. It wraps the last error seen so callers can keep using
+// errors.As/errors.Is against the underlying awserr.Error, while also
+// exposing the full attempt history for debug logs.
+type RetryError struct {
+	Attempts []Attempt
+	Last     error
+}
+
+func (e *RetryError) Error() string {
+	return fmt.Sprintf("giving up after %d attempt(s): %s", len(e.Attempts), e.Last)
+}
+
+func (e *RetryError) Unwrap() error {
+	return e.Last
+}
+
+// Do calls f, retrying according to policy until it succeeds, ctx is
+// cancelled, or the policy's attempt or time budget is exhausted. Errors
+// not matched by policy.Matchers are returned immediately without retry.
+func Do(ctx context.Context, policy RetryPolicy, f func() (interface{}, error)) (interface{}, error) {
+	if policy.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, policy.Timeout)
+		defer cancel()
+	}
+
+	var attempts []Attempt
+	for attempt := 1; ; attempt++ {
+		resp, err := f()
+		if err == nil {
+			return resp, nil
+		}
+		if !policy.retryable(err) {
+			return resp, err
+		}
+
+		if policy.MaxAttempts > 0 && attempt >= policy.MaxAttempts {
+			attempts = append(attempts, Attempt{Number: attempt, Err: err})
+			return resp, &RetryError{Attempts: attempts, Last: err}
+		}
+
+		d := policy.delay(attempt)
+		attempts = append(attempts, Attempt{Number: attempt, Err: err, Delay: d})
+
+		select {
+		case <-ctx.Done():
+			return resp, &RetryError{Attempts: attempts, Last: err}
+		case <-time.After(d):
+		}
+	}
+}
@@ -0,0 +1,130 @@
+package awsretry
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+func TestRetryPolicyDelayGrowthAndCap(t *testing.T) {
+	policy := RetryPolicy{
+		InitialDelay: 100 * time.Millisecond,
+		MaxDelay:     1 * time.Second,
+		Multiplier:   2,
+	}
+
+	tests := []struct {
+		attempt int
+		want    time.Duration
+	}{
+		{1, 100 * time.Millisecond},
+		{2, 200 * time.Millisecond},
+		{3, 400 * time.Millisecond},
+		{4, 800 * time.Millisecond},
+		{5, 1 * time.Second}, // capped
+		{10, 1 * time.Second},
+	}
+
+	for _, tt := range tests {
+		if got := policy.delay(tt.attempt); got != tt.want {
+			t.Errorf("delay(%d) = %v, want %v", tt.attempt, got, tt.want)
+		}
+	}
+}
+
+func TestRetryPolicyDelayJitterNeverExceedsMaxDelay(t *testing.T) {
+	policy := RetryPolicy{
+		InitialDelay:   1 * time.Second,
+		MaxDelay:       2 * time.Second,
+		Multiplier:     2,
+		JitterFraction: 0.5,
+	}
+
+	for attempt := 1; attempt <= 20; attempt++ {
+		for i := 0; i < 50; i++ {
+			if d := policy.delay(attempt); d > policy.MaxDelay {
+				t.Fatalf("delay(%d) = %v, want <= %v", attempt, d, policy.MaxDelay)
+			}
+		}
+	}
+}
+
+func TestDoSucceedsWithoutRetry(t *testing.T) {
+	calls := 0
+	resp, err := Do(context.Background(), RetryPolicy{}, func() (interface{}, error) {
+		calls++
+		return "ok", nil
+	})
+	if err != nil || resp != "ok" || calls != 1 {
+		t.Fatalf("Do() = (%v, %v), calls = %d", resp, err, calls)
+	}
+}
+
+func TestDoReturnsImmediatelyForUnmatchedError(t *testing.T) {
+	wantErr := errors.New("boom")
+	calls := 0
+	_, err := Do(context.Background(), RetryPolicy{
+		Matchers: []Matcher{MatchErrCode("Throttling")},
+	}, func() (interface{}, error) {
+		calls++
+		return nil, wantErr
+	})
+	if err != wantErr || calls != 1 {
+		t.Fatalf("Do() err = %v, calls = %d, want %v, 1", err, calls, wantErr)
+	}
+}
+
+func TestDoExhaustsMaxAttempts(t *testing.T) {
+	matchErr := awserr.New("Throttling", "slow down", nil)
+	calls := 0
+	_, err := Do(context.Background(), RetryPolicy{
+		Matchers:     []Matcher{MatchErrCode("Throttling")},
+		InitialDelay: time.Millisecond,
+		MaxAttempts:  3,
+	}, func() (interface{}, error) {
+		calls++
+		return nil, matchErr
+	})
+
+	var retryErr *RetryError
+	if !errors.As(err, &retryErr) {
+		t.Fatalf("Do() err = %v, want *RetryError", err)
+	}
+	if calls != 3 {
+		t.Fatalf("calls = %d, want 3", calls)
+	}
+	if len(retryErr.Attempts) != 3 {
+		t.Fatalf("len(Attempts) = %d, want 3", len(retryErr.Attempts))
+	}
+	if retryErr.Unwrap() != matchErr {
+		t.Fatal("RetryError does not unwrap to the last error")
+	}
+}
+
+func TestDoRespectsContextCancellation(t *testing.T) {
+	matchErr := awserr.New("Throttling", "slow down", nil)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	calls := 0
+	_, err := Do(ctx, RetryPolicy{
+		Matchers:     []Matcher{MatchErrCode("Throttling")},
+		InitialDelay: 50 * time.Millisecond,
+	}, func() (interface{}, error) {
+		calls++
+		if calls == 1 {
+			cancel()
+		}
+		return nil, matchErr
+	})
+
+	var retryErr *RetryError
+	if !errors.As(err, &retryErr) {
+		t.Fatalf("Do() err = %v, want *RetryError", err)
+	}
+	if calls != 1 {
+		t.Fatalf("calls = %d, want 1 (cancellation should stop further retries)", calls)
+	}
+}
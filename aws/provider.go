@@ -0,0 +1,44 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Provider returns the provider's schema.Provider. It declares only the
+// decode_authorization_messages argument, the configure wiring, and the
+// aws_iam_role resource; no data sources are registered.
+func Provider() *schema.Provider {
+	return &schema.Provider{
+		Schema: map[string]*schema.Schema{
+			"decode_authorization_messages": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "If true, automatically decode STS EncodedAuthorizationMessage " +
+					"values returned by any AWS API error into their human-readable form.",
+			},
+		},
+		ResourcesMap: map[string]*schema.Resource{
+			"aws_iam_role": resourceAwsIamRole(),
+		},
+		ConfigureContextFunc: providerConfigure,
+	}
+}
+
+func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+	config := &Config{
+		DecodeAuthorizationMessages: d.Get("decode_authorization_messages").(bool),
+	}
+
+	sess, err := session.NewSession()
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	config.ConfigureSession(sess)
+
+	return config.Client(sess), nil
+}
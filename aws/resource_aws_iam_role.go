@@ -0,0 +1,143 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/awserrors"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/awsretry"
+)
+
+// iamRoleErrorClassifier is one table of isAWSErr(err, "Throttling", "")
+// style checks, built once and consulted through iamRoleRetryPolicy by the
+// Read and Delete functions; Create and Update do not consult it and do
+// not retry throttled calls. NoSuchEntityException is handled
+// via awserrors.AsNotFound instead, since registry_iam.go already registers
+// it there.
+var iamRoleErrorClassifier = NewAWSErrorClassifier().
+	WithCode(ClassThrottled, "Throttling")
+
+// iamRoleRetryPolicy backs off and retries GetRole/DeleteRole calls the
+// classifier above tags as throttled, instead of failing the CRUD
+// operation on the first Throttling response.
+var iamRoleRetryPolicy = awsretry.RetryPolicy{
+	Matchers: []awsretry.Matcher{func(err error) bool {
+		class, ok := iamRoleErrorClassifier.Classify(err)
+		return ok && class == ClassThrottled
+	}},
+	InitialDelay:   1 * time.Second,
+	MaxDelay:       30 * time.Second,
+	Multiplier:     2,
+	JitterFraction: 0.1,
+	Timeout:        1 * time.Minute,
+}
+
+func resourceAwsIamRole() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsIamRoleCreate,
+		Read:   resourceAwsIamRoleRead,
+		Update: resourceAwsIamRoleUpdate,
+		Delete: resourceAwsIamRoleDelete,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"assume_role_policy": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsIamRoleCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+
+	output, err := conn.CreateRole(&iam.CreateRoleInput{
+		RoleName:                 aws.String(d.Get("name").(string)),
+		AssumeRolePolicyDocument: aws.String(d.Get("assume_role_policy").(string)),
+	})
+	if err != nil {
+		return fmt.Errorf("error creating IAM role: %w", awserrors.Wrap("iam", err))
+	}
+
+	d.SetId(aws.StringValue(output.Role.RoleName))
+
+	return resourceAwsIamRoleRead(d, meta)
+}
+
+func resourceAwsIamRoleRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+
+	outputRaw, err := awsretry.Do(context.Background(), iamRoleRetryPolicy, func() (interface{}, error) {
+		return conn.GetRole(&iam.GetRoleInput{RoleName: aws.String(d.Id())})
+	})
+	if err != nil {
+		err = awserrors.Wrap("iam", err)
+		if _, ok := awserrors.AsNotFound(err); ok {
+			log.Printf("[WARN] IAM Role (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error reading IAM role (%s): %w", d.Id(), err)
+	}
+	output := outputRaw.(*iam.GetRoleOutput)
+
+	// IAM returns AssumeRolePolicyDocument URL-encoded.
+	policy, err := url.QueryUnescape(aws.StringValue(output.Role.AssumeRolePolicyDocument))
+	if err != nil {
+		return fmt.Errorf("error decoding IAM role (%s) assume role policy: %w", d.Id(), err)
+	}
+
+	d.Set("name", output.Role.RoleName)
+	d.Set("arn", output.Role.Arn)
+	d.Set("assume_role_policy", policy)
+
+	return nil
+}
+
+func resourceAwsIamRoleUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+
+	if d.HasChange("assume_role_policy") {
+		_, err := conn.UpdateAssumeRolePolicy(&iam.UpdateAssumeRolePolicyInput{
+			RoleName:       aws.String(d.Id()),
+			PolicyDocument: aws.String(d.Get("assume_role_policy").(string)),
+		})
+		if err != nil {
+			return fmt.Errorf("error updating IAM role (%s) assume role policy: %w", d.Id(), awserrors.Wrap("iam", err))
+		}
+	}
+
+	return resourceAwsIamRoleRead(d, meta)
+}
+
+func resourceAwsIamRoleDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+
+	_, err := awsretry.Do(context.Background(), iamRoleRetryPolicy, func() (interface{}, error) {
+		return conn.DeleteRole(&iam.DeleteRoleInput{RoleName: aws.String(d.Id())})
+	})
+	if err != nil {
+		err = awserrors.Wrap("iam", err)
+		if _, ok := awserrors.AsNotFound(err); ok {
+			return nil
+		}
+		return fmt.Errorf("error deleting IAM role (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}